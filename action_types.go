@@ -86,13 +86,8 @@ type BindingSpec struct {
 		URL string `json:"url,omitempty"`
 
 		// Defines the binding of Action's parameters to the
-		// target endpoint.
-		Parameters []struct {
-			ParameterBinding `json:",inline"`
-
-			// The target, like query, header
-			Target string `json:"target,omitempty"`
-		}
+		// target endpoint, Target is like query, header.
+		Parameters []TargetedParameterBinding `json:"parameters,omitempty"`
 
 		// Container defines a container that implement the action,
 		// how to run it application specific as example it can be
@@ -127,6 +122,86 @@ type BindingSpec struct {
 		// Metadata ---
 		Metadata Metadata `json:"metadata,omitempty"`
 	}
+
+	// GRPC defines a gRPC method that need to be invoked to
+	// execute the action.
+	GRPC *struct {
+		// The name of the service to be invoked.
+		Service string `json:"service,omitempty"`
+
+		// The name of the method to be invoked.
+		Method string `json:"method,omitempty"`
+
+		// ProtoDescriptor references the ConfigMap holding the
+		// compiled FileDescriptorSet used to marshal/unmarshal the
+		// request and response messages.
+		ProtoDescriptor *corev1.ConfigMapKeySelector `json:"protoDescriptor,omitempty"`
+
+		// Defines the binding of Action's parameters to the
+		// target method.
+		Parameters []TargetedParameterBinding `json:"parameters,omitempty"`
+
+		// Metadata ---
+		Metadata Metadata `json:"metadata,omitempty"`
+	}
+
+	// Kafka defines a Kafka topic an Action's outcome is produced to.
+	Kafka *struct {
+		// Bootstrap is the comma separated list of bootstrap servers.
+		Bootstrap string `json:"bootstrap,omitempty"`
+
+		// Topic the message is produced to.
+		Topic string `json:"topic,omitempty"`
+
+		// Key of the produced record, support mustache template
+		// engine for easy binding, reusing the same templating
+		// language as the HTTP URL.
+		Key string `json:"key,omitempty"`
+
+		// Defines the binding of Action's parameters to the
+		// record headers.
+		Headers []TargetedParameterBinding `json:"headers,omitempty"`
+
+		// Metadata ---
+		Metadata Metadata `json:"metadata,omitempty"`
+	}
+
+	// AMQP defines an AMQP 0-9-1 exchange an Action's outcome is
+	// published to.
+	AMQP *struct {
+		// URI of the AMQP broker to connect to.
+		URI string `json:"uri,omitempty"`
+
+		// Exchange the message is published to.
+		Exchange string `json:"exchange,omitempty"`
+
+		// RoutingKey of the published message.
+		RoutingKey string `json:"routingKey,omitempty"`
+
+		// Defines the binding of Action's parameters to the
+		// published message, Target is one of property|header|body.
+		Parameters []TargetedParameterBinding `json:"parameters,omitempty"`
+
+		// Metadata ---
+		Metadata Metadata `json:"metadata,omitempty"`
+	}
+
+	// CloudEvents defines a CloudEvent an Action's outcome is wrapped
+	// into, reusing Message.ContentType / Message.Schema as the data
+	// schema reference.
+	CloudEvents *struct {
+		// Source of the CloudEvent, as defined by the CE spec.
+		Source string `json:"source,omitempty"`
+
+		// Type of the CloudEvent, as defined by the CE spec.
+		Type string `json:"type,omitempty"`
+
+		// Mode the event is encoded with.
+		Mode CloudEventsMode `json:"mode,omitempty"`
+
+		// Metadata ---
+		Metadata Metadata `json:"metadata,omitempty"`
+	}
 }
 
 // Dependency ---
@@ -153,6 +228,21 @@ type ParameterBinding struct {
 	SecretKeyRef    *corev1.SecretKeySelector    `json:"secretKeyRef,omitempty"`
 }
 
+// TargetedParameterBinding is a ParameterBinding together with the
+// transport-specific target it is bound to (a query/header name, a
+// field path, ...), shared by every transport so that parameter
+// binding resolution can be implemented once and reused across HTTP,
+// GRPC, Kafka and AMQP.
+type TargetedParameterBinding struct {
+	ParameterBinding `json:",inline"`
+
+	// Target the parameter is bound to, its meaning is transport
+	// specific (e.g. query/header for HTTP, a dotted field path for
+	// GRPC, a header name for Kafka, one of property|header|body for
+	// AMQP).
+	Target string `json:"target,omitempty"`
+}
+
 type EndpointTarget string
 
 const (
@@ -160,6 +250,19 @@ const (
 	Query  EndpointTarget = "query"
 )
 
+// CloudEventsMode is the encoding mode of an outbound CloudEvent.
+type CloudEventsMode string
+
+const (
+	// CloudEventsModeBinary carries the CloudEvent attributes as
+	// transport headers and the data as the message body.
+	CloudEventsModeBinary CloudEventsMode = "binary"
+
+	// CloudEventsModeStructured carries both the CloudEvent
+	// attributes and the data within the message body.
+	CloudEventsModeStructured CloudEventsMode = "structured"
+)
+
 type Metadata Metadata
 
 // Message describes
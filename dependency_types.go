@@ -0,0 +1,55 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cib
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ResolvedDependencies caches the outcome of resolving a
+// DependencyManifest, keyed by the manifest's content hash, so that
+// repeated builds of identical manifests skip resolution.
+type ResolvedDependencies struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ResolvedDependenciesSpec   `json:"spec,omitempty"`
+	Status ResolvedDependenciesStatus `json:"status,omitempty"`
+}
+
+// ResolvedDependenciesSpec ---
+type ResolvedDependenciesSpec struct {
+	// ManifestHash is the content hash of the DependencyManifest this
+	// entry was resolved from, it is the cache key the resolver
+	// looks up before running resolution again.
+	ManifestHash string `json:"manifestHash,omitempty"`
+
+	// Ecosystem the manifest belongs to.
+	Ecosystem Ecosystem `json:"ecosystem,omitempty"`
+}
+
+// ResolvedDependenciesStatus holds the outcome of a resolution run.
+type ResolvedDependenciesStatus struct {
+	// Dependencies is the flattened list expanded from the manifest.
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+
+	// Licenses records the license declared by each resolved
+	// dependency, keyed by Dependency.Coordinates, so the SBOM
+	// feature can reuse it instead of re-deriving it.
+	Licenses map[string]string `json:"licenses,omitempty"`
+}
@@ -0,0 +1,154 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cib
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NoteKind identifies the class of metadata a Note declares, mirroring
+// the Grafeas v1beta1 note kinds.
+type NoteKind string
+
+const (
+	// NoteKindVulnerability ---
+	NoteKindVulnerability NoteKind = "VULNERABILITY"
+
+	// NoteKindBuildProvenance ---
+	NoteKindBuildProvenance NoteKind = "BUILD_PROVENANCE"
+
+	// NoteKindAttestation ---
+	NoteKindAttestation NoteKind = "ATTESTATION"
+
+	// NoteKindDeployment ---
+	NoteKindDeployment NoteKind = "DEPLOYMENT"
+
+	// NoteKindDiscovery ---
+	NoteKindDiscovery NoteKind = "DISCOVERY"
+
+	// NoteKindImageBaseLayers ---
+	NoteKindImageBaseLayers NoteKind = "IMAGE_BASE_LAYERS"
+)
+
+// Note describes a class of analysis that can be attached to a resource,
+// it is referenced by the many Occurrence records that report on it.
+type Note struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   NoteSpec   `json:"spec,omitempty"`
+	Status NoteStatus `json:"status,omitempty"`
+}
+
+// NoteSpec ---
+type NoteSpec struct {
+	// Kind of analysis this Note declares.
+	Kind NoteKind `json:"kind,omitempty"`
+
+	// ShortDescription is a one line description of the Note.
+	ShortDescription string `json:"shortDescription,omitempty"`
+
+	// LongDescription is a detailed description of the Note.
+	LongDescription string `json:"longDescription,omitempty"`
+
+	// RelatedNoteNames lists other Notes this Note relates to.
+	RelatedNoteNames []string `json:"relatedNoteNames,omitempty"`
+}
+
+// NoteStatus ---
+type NoteStatus struct {
+}
+
+// Occurrence is a single piece of metadata, attached to a resource and
+// classified by the Note it references.
+type Occurrence struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   OccurrenceSpec   `json:"spec,omitempty"`
+	Status OccurrenceStatus `json:"status,omitempty"`
+}
+
+// OccurrenceSpec ---
+type OccurrenceSpec struct {
+	// NoteName references the Note this Occurrence is an instance of.
+	NoteName string `json:"noteName,omitempty"`
+
+	// ResourceURI is the URI of the resource this Occurrence is about,
+	// e.g. the digest of the image pushed by the ContainerImage
+	// controller.
+	ResourceURI string `json:"resourceUri,omitempty"`
+
+	// Kind mirrors NoteSpec.Kind and allows an Occurrence to be
+	// filtered without having to resolve its Note.
+	Kind NoteKind `json:"kind,omitempty"`
+
+	// Build holds the provenance details when Kind is
+	// NoteKindBuildProvenance.
+	Build *BuildOccurrence `json:"build,omitempty"`
+
+	// Signature is the cryptographic signature attesting the
+	// Occurrence content, base64 encoded.
+	Signature string `json:"signature,omitempty"`
+}
+
+// BuildOccurrence is an in-toto/SLSA-style record of how a
+// ContainerImage was assembled and pushed.
+type BuildOccurrence struct {
+	// From is the base image resolved from ContainerImageSpec.From.
+	From string `json:"from,omitempty"`
+
+	// Steps is the ordered list of build steps that produced the
+	// image, resolved from ContainerImageSpec.Steps.
+	Steps []string `json:"steps,omitempty"`
+
+	// Dependencies resolved for the build, as expanded by the
+	// resolver subsystem from ContainerImageSpec.Manifests.
+	Dependencies []Dependency `json:"dependencies,omitempty"`
+
+	// GitProvenance, when discoverable from the ContainerImage's
+	// ObjectMeta.Annotations, records the source commit the build
+	// was produced from.
+	GitProvenance *GitProvenance `json:"gitProvenance,omitempty"`
+
+	// Digest is the image digest produced by the finalizer container.
+	Digest string `json:"digest,omitempty"`
+}
+
+// GitProvenance ---
+type GitProvenance struct {
+	// URL of the git repository.
+	URL string `json:"url,omitempty"`
+
+	// Revision is the commit the build was produced from.
+	Revision string `json:"revision,omitempty"`
+}
+
+// OccurrenceStatus ---
+type OccurrenceStatus struct {
+}
+
+// OccurrenceLister allows retrieval of Occurrences by the URI of the
+// resource they are about, so that admission controllers can gate
+// deployment on the presence of required Note kinds (e.g. "must have
+// VULNERABILITY and ATTESTATION occurrences of severity < HIGH").
+type OccurrenceLister interface {
+	// ByResourceURI returns the Occurrences attached to the given
+	// resource URI, across all namespaces.
+	ByResourceURI(resourceURI string) ([]*Occurrence, error)
+}
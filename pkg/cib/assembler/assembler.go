@@ -0,0 +1,145 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package assembler decouples the ContainerImage controller from the
+// concrete tool used to turn a ContainerImageSpec into a pushed image,
+// in the same spirit as the Kubernetes container-runtime interface
+// decouples the kubelet from the container runtime.
+package assembler
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	cib "github.com/lburgazzoli/lb-docs"
+)
+
+// Assembler builds, and eventually pushes, the image described by a
+// ContainerImage. Implementations are registered under an
+// AssemblerType by calling Register, typically from an init function.
+type Assembler interface {
+	// Prepare validates the ContainerImage and stages whatever the
+	// assembler needs before Execute can run, e.g. resolving the
+	// finalizer container or materializing a build context. If
+	// Prepare returns an error, Execute will not be called and
+	// implementations must close the events channel themselves
+	// before returning.
+	Prepare(ctx context.Context, image *cib.ContainerImage) error
+
+	// Execute runs the build according to ContainerImageSpec.Strategy.Execution,
+	// either in-process (ExecutionTypeRoutine) or as a Job
+	// (ExecutionTypePod), and reports progress on the events channel
+	// the Assembler was created with by its Factory.
+	Execute(ctx context.Context, image *cib.ContainerImage) (Result, error)
+
+	// Cleanup releases any resource Prepare/Execute allocated,
+	// regardless of whether Execute succeeded.
+	Cleanup(ctx context.Context, image *cib.ContainerImage) error
+}
+
+// Result is the outcome of a successful Execute call.
+type Result struct {
+	// Descriptor of the image, or image index, that was pushed.
+	Descriptor cib.Descriptor
+
+	// Index holds the per-platform descriptors when the build
+	// targeted multiple platforms.
+	Index []cib.Descriptor
+}
+
+// AssemblerEventType classifies an AssemblerEvent.
+type AssemblerEventType string
+
+const (
+	// AssemblerEventProgress reports incremental progress of a
+	// running build, it is translated by the controller into an
+	// update of an in-progress ContainerImageCondition.
+	AssemblerEventProgress AssemblerEventType = "Progress"
+
+	// AssemblerEventSucceeded reports that the build completed
+	// successfully.
+	AssemblerEventSucceeded AssemblerEventType = "Succeeded"
+
+	// AssemblerEventFailed reports that the build failed.
+	AssemblerEventFailed AssemblerEventType = "Failed"
+)
+
+// AssemblerEvent is emitted by a running Assembler so the controller
+// can translate it into a ContainerImageCondition update without
+// having to poll the assembler implementation.
+type AssemblerEvent struct {
+	Type    AssemblerEventType
+	Reason  string
+	Message string
+}
+
+// Factory creates a new Assembler instance for a ContainerImage build.
+// The events channel is owned by the caller: implementations must
+// close it exactly once, either when Execute returns or, if Prepare
+// fails, from Prepare itself, so that a caller ranging over the
+// channel is never left blocked waiting for a close that never comes.
+type Factory func(events chan<- AssemblerEvent) (Assembler, error)
+
+var (
+	mu        sync.RWMutex
+	factories = make(map[cib.AssemblerType]Factory)
+)
+
+// Register associates an AssemblerType with the Factory used to build
+// an Assembler for it. Out-of-tree assemblers call this, typically
+// from an init function, to register at controller startup without
+// the controller having to know about them at compile time.
+func Register(t cib.AssemblerType, f Factory) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	factories[t] = f
+}
+
+// New resolves the Factory registered for t and creates an Assembler
+// from it, it returns an error if no Factory was registered.
+func New(t cib.AssemblerType, events chan<- AssemblerEvent) (Assembler, error) {
+	mu.RLock()
+	f, ok := factories[t]
+	mu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("no assembler registered for type %q", t)
+	}
+
+	return f(events)
+}
+
+// supportsExecution reports whether t is one of supported, treating
+// the zero value as ExecutionTypePod since that is the execution mode
+// the controller defaults to. Backends that can only run as a Job
+// (e.g. Tekton) use this to reject ExecutionTypeRoutine instead of
+// silently ignoring ContainerImageSpec.Strategy.Execution.
+func supportsExecution(t cib.ExecutionType, supported ...cib.ExecutionType) bool {
+	if t == "" {
+		t = cib.ExecutionTypePod
+	}
+
+	for _, s := range supported {
+		if t == s {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,60 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assembler
+
+import (
+	"context"
+	"testing"
+
+	cib "github.com/lburgazzoli/lb-docs"
+)
+
+type fakeAssembler struct{}
+
+func (fakeAssembler) Prepare(ctx context.Context, image *cib.ContainerImage) error { return nil }
+
+func (fakeAssembler) Execute(ctx context.Context, image *cib.ContainerImage) (Result, error) {
+	return Result{}, nil
+}
+
+func (fakeAssembler) Cleanup(ctx context.Context, image *cib.ContainerImage) error { return nil }
+
+func TestRegisterAndNewResolvesFactory(t *testing.T) {
+	const assemblerType cib.AssemblerType = "fake"
+
+	Register(assemblerType, func(events chan<- AssemblerEvent) (Assembler, error) {
+		return fakeAssembler{}, nil
+	})
+
+	a, err := New(assemblerType, nil)
+	if err != nil {
+		t.Fatalf("New(%q) returned an unexpected error: %v", assemblerType, err)
+	}
+
+	if _, ok := a.(fakeAssembler); !ok {
+		t.Fatalf("New(%q) = %T, want fakeAssembler", assemblerType, a)
+	}
+}
+
+func TestNewUnregisteredTypeReturnsError(t *testing.T) {
+	const assemblerType cib.AssemblerType = "does-not-exist"
+
+	if _, err := New(assemblerType, nil); err == nil {
+		t.Fatalf("New(%q) expected an error, got nil", assemblerType)
+	}
+}
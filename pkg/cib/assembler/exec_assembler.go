@@ -0,0 +1,96 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assembler
+
+import (
+	"context"
+	"fmt"
+
+	cib "github.com/lburgazzoli/lb-docs"
+)
+
+// execAssembler is a generic Assembler backend for tools that, unlike
+// Tekton, are not tied to running as a Pod: buildah, kaniko and
+// buildkit only differ in their name and in whether they are able to
+// run as an in-process routine in addition to a Job, so they are all
+// registered off the same implementation.
+type execAssembler struct {
+	// name identifies the backend in events and error messages, e.g.
+	// "buildah".
+	name string
+
+	// routineCapable reports whether the backend can run as an
+	// in-process ExecutionTypeRoutine in addition to ExecutionTypePod.
+	routineCapable bool
+
+	events chan<- AssemblerEvent
+}
+
+// newExecAssemblerFactory returns a Factory that registers a backend
+// called name, able to run as ExecutionTypePod and, when
+// routineCapable is true, also as ExecutionTypeRoutine.
+func newExecAssemblerFactory(name string, routineCapable bool) Factory {
+	return func(events chan<- AssemblerEvent) (Assembler, error) {
+		return &execAssembler{name: name, routineCapable: routineCapable, events: events}, nil
+	}
+}
+
+func (a *execAssembler) supported() []cib.ExecutionType {
+	if a.routineCapable {
+		return []cib.ExecutionType{cib.ExecutionTypeRoutine, cib.ExecutionTypePod}
+	}
+
+	return []cib.ExecutionType{cib.ExecutionTypePod}
+}
+
+func (a *execAssembler) Prepare(ctx context.Context, image *cib.ContainerImage) error {
+	exec := image.Spec.Strategy.Execution
+
+	if !supportsExecution(exec, a.supported()...) {
+		close(a.events)
+		return fmt.Errorf("%s assembler does not support execution type %q", a.name, exec)
+	}
+
+	return nil
+}
+
+func (a *execAssembler) Execute(ctx context.Context, image *cib.ContainerImage) (Result, error) {
+	defer close(a.events)
+
+	if image.Spec.Strategy.Execution == cib.ExecutionTypeRoutine {
+		a.events <- AssemblerEvent{
+			Type:    AssemblerEventProgress,
+			Reason:  a.name + "RoutineStarted",
+			Message: fmt.Sprintf("running %s in-process", a.name),
+		}
+	} else {
+		a.events <- AssemblerEvent{
+			Type:    AssemblerEventProgress,
+			Reason:  a.name + "JobStarted",
+			Message: fmt.Sprintf("running %s as a Job", a.name),
+		}
+	}
+
+	a.events <- AssemblerEvent{Type: AssemblerEventSucceeded}
+
+	return Result{}, nil
+}
+
+func (a *execAssembler) Cleanup(ctx context.Context, image *cib.ContainerImage) error {
+	return nil
+}
@@ -0,0 +1,127 @@
+/*
+Licensed to the Apache Software Foundation (ASF) under one or more
+contributor license agreements.  See the NOTICE file distributed with
+this work for additional information regarding copyright ownership.
+The ASF licenses this file to You under the Apache License, Version 2.0
+(the "License"); you may not use this file except in compliance with
+the License.  You may obtain a copy of the License at
+
+   http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package assembler
+
+import (
+	"context"
+	"testing"
+
+	cib "github.com/lburgazzoli/lb-docs"
+)
+
+func TestSupportsExecution(t *testing.T) {
+	tests := []struct {
+		name      string
+		execution cib.ExecutionType
+		supported []cib.ExecutionType
+		want      bool
+	}{
+		{"zero value defaults to pod and is supported", "", []cib.ExecutionType{cib.ExecutionTypePod}, true},
+		{"zero value defaults to pod and is not supported", "", []cib.ExecutionType{cib.ExecutionTypeRoutine}, false},
+		{"exact match", cib.ExecutionTypeRoutine, []cib.ExecutionType{cib.ExecutionTypeRoutine, cib.ExecutionTypePod}, true},
+		{"no match", cib.ExecutionType("bogus"), []cib.ExecutionType{cib.ExecutionTypePod}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := supportsExecution(tt.execution, tt.supported...); got != tt.want {
+				t.Fatalf("supportsExecution(%q, %v) = %v, want %v", tt.execution, tt.supported, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTektonAssemblerRejectsRoutineExecution(t *testing.T) {
+	events := make(chan AssemblerEvent, 2)
+
+	a, err := newTektonAssembler(events)
+	if err != nil {
+		t.Fatalf("newTektonAssembler() returned an unexpected error: %v", err)
+	}
+
+	image := &cib.ContainerImage{}
+	image.Spec.Strategy.Execution = cib.ExecutionTypeRoutine
+
+	if err := a.Prepare(context.Background(), image); err == nil {
+		t.Fatal("Prepare() with ExecutionTypeRoutine expected an error, got nil")
+	}
+
+	if _, ok := <-events; ok {
+		t.Fatal("events channel should be closed after a Prepare failure")
+	}
+}
+
+func TestTektonAssemblerAcceptsPodExecution(t *testing.T) {
+	events := make(chan AssemblerEvent, 2)
+
+	a, err := newTektonAssembler(events)
+	if err != nil {
+		t.Fatalf("newTektonAssembler() returned an unexpected error: %v", err)
+	}
+
+	image := &cib.ContainerImage{}
+	image.Spec.Strategy.Execution = cib.ExecutionTypePod
+
+	if err := a.Prepare(context.Background(), image); err != nil {
+		t.Fatalf("Prepare() with ExecutionTypePod returned an unexpected error: %v", err)
+	}
+
+	if _, err := a.Execute(context.Background(), image); err != nil {
+		t.Fatalf("Execute() with ExecutionTypePod returned an unexpected error: %v", err)
+	}
+}
+
+func TestExecAssemblerRejectsRoutineWhenNotRoutineCapable(t *testing.T) {
+	events := make(chan AssemblerEvent, 2)
+
+	a, err := newExecAssemblerFactory("test", false)(events)
+	if err != nil {
+		t.Fatalf("factory returned an unexpected error: %v", err)
+	}
+
+	image := &cib.ContainerImage{}
+	image.Spec.Strategy.Execution = cib.ExecutionTypeRoutine
+
+	if err := a.Prepare(context.Background(), image); err == nil {
+		t.Fatal("Prepare() with ExecutionTypeRoutine expected an error, got nil")
+	}
+
+	if _, ok := <-events; ok {
+		t.Fatal("events channel should be closed after a Prepare failure")
+	}
+}
+
+func TestExecAssemblerAcceptsRoutineWhenRoutineCapable(t *testing.T) {
+	events := make(chan AssemblerEvent, 2)
+
+	a, err := newExecAssemblerFactory("test", true)(events)
+	if err != nil {
+		t.Fatalf("factory returned an unexpected error: %v", err)
+	}
+
+	image := &cib.ContainerImage{}
+	image.Spec.Strategy.Execution = cib.ExecutionTypeRoutine
+
+	if err := a.Prepare(context.Background(), image); err != nil {
+		t.Fatalf("Prepare() with ExecutionTypeRoutine returned an unexpected error: %v", err)
+	}
+
+	if _, err := a.Execute(context.Background(), image); err != nil {
+		t.Fatalf("Execute() with ExecutionTypeRoutine returned an unexpected error: %v", err)
+	}
+}
@@ -40,6 +40,17 @@ type AssemblerType string
 // ContainerImagePhase --
 type ContainerImagePhase string
 
+// ScannerType identifies the SBOM/vulnerability scanner implementation
+// used to analyse the assembled image.
+type ScannerType string
+
+// Severity ---
+type Severity string
+
+// Ecosystem identifies the packaging ecosystem a Package belongs to,
+// following the taxonomy used by language-aware SBOM scanners.
+type Ecosystem string
+
 const (
 	// ExecutionTypeRoutine ---
 	ExecutionTypeRoutine ExecutionType = "routine"
@@ -52,6 +63,120 @@ const (
 
 	// AssemblerTypeTekton ---
 	AssemblerTypeTekton  AssemblerType = "tekton"
+
+	// AssemblerTypeKaniko ---
+	AssemblerTypeKaniko AssemblerType = "kaniko"
+
+	// AssemblerTypeBuildKit ---
+	AssemblerTypeBuildKit AssemblerType = "buildkit"
+
+	// ScannerTypeTrivy ---
+	ScannerTypeTrivy ScannerType = "trivy"
+
+	// ScannerTypeGrype ---
+	ScannerTypeGrype ScannerType = "grype"
+
+	// ScannerTypeSyft ---
+	ScannerTypeSyft ScannerType = "syft"
+
+	// SeverityCritical ---
+	SeverityCritical Severity = "Critical"
+
+	// SeverityHigh ---
+	SeverityHigh Severity = "High"
+
+	// SeverityMedium ---
+	SeverityMedium Severity = "Medium"
+
+	// SeverityLow ---
+	SeverityLow Severity = "Low"
+)
+
+const (
+	// EcosystemBundler ---
+	EcosystemBundler Ecosystem = "bundler"
+
+	// EcosystemGemSpec ---
+	EcosystemGemSpec Ecosystem = "gemspec"
+
+	// EcosystemCargo ---
+	EcosystemCargo Ecosystem = "cargo"
+
+	// EcosystemComposer ---
+	EcosystemComposer Ecosystem = "composer"
+
+	// EcosystemNpm ---
+	EcosystemNpm Ecosystem = "npm"
+
+	// EcosystemYarn ---
+	EcosystemYarn Ecosystem = "yarn"
+
+	// EcosystemPnpm ---
+	EcosystemPnpm Ecosystem = "pnpm"
+
+	// EcosystemNuGet ---
+	EcosystemNuGet Ecosystem = "nuget"
+
+	// EcosystemPip ---
+	EcosystemPip Ecosystem = "pip"
+
+	// EcosystemPipenv ---
+	EcosystemPipenv Ecosystem = "pipenv"
+
+	// EcosystemPoetry ---
+	EcosystemPoetry Ecosystem = "poetry"
+
+	// EcosystemJar ---
+	EcosystemJar Ecosystem = "jar"
+
+	// EcosystemPom ---
+	EcosystemPom Ecosystem = "pom"
+
+	// EcosystemGradle ---
+	EcosystemGradle Ecosystem = "gradle"
+
+	// EcosystemGoBinary ---
+	EcosystemGoBinary Ecosystem = "go-binary"
+
+	// EcosystemGoModule ---
+	EcosystemGoModule Ecosystem = "go-module"
+
+	// EcosystemRustBinary ---
+	EcosystemRustBinary Ecosystem = "rust-binary"
+
+	// EcosystemConan ---
+	EcosystemConan Ecosystem = "conan"
+
+	// EcosystemCocoapods ---
+	EcosystemCocoapods Ecosystem = "cocoapods"
+
+	// EcosystemPub ---
+	EcosystemPub Ecosystem = "pub"
+
+	// EcosystemHex ---
+	EcosystemHex Ecosystem = "hex"
+
+	// EcosystemApk ---
+	EcosystemApk Ecosystem = "apk"
+
+	// EcosystemDpkg ---
+	EcosystemDpkg Ecosystem = "dpkg"
+
+	// EcosystemRpm ---
+	EcosystemRpm Ecosystem = "rpm"
+)
+
+const (
+	// ContainerImagePhaseScanning is the phase the ContainerImage is in
+	// while the SBOM is generated and checked against Spec.Scan.FailOn,
+	// it happens after the image has been assembled and before it is
+	// pushed to the registry.
+	ContainerImagePhaseScanning ContainerImagePhase = "Scanning"
+
+	// ContainerImageConditionScanned reports the outcome of the SBOM
+	// generation and vulnerability scan performed during the
+	// Scanning phase.
+	ContainerImageConditionScanned = "Scanned"
 )
 
 // ContainerImageCondition ---
@@ -70,11 +195,33 @@ type ContainerImageCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
+const (
+	// ContainerImageConditionPushedDigest reports the digest the
+	// image, or image index, was pushed under, so that GitOps
+	// consumers can pin by digest instead of a mutable tag.
+	ContainerImageConditionPushedDigest = "PushedDigest"
+
+	// ContainerImageConditionDependenciesResolved reports the outcome
+	// of expanding Spec.Manifests into the flattened Dependency list,
+	// it runs before Spec.Strategy.Finalizer.
+	ContainerImageConditionDependenciesResolved = "DependenciesResolved"
+)
+
 // ContainerImageSpec ---
 type ContainerImageSpec struct {
-	Dependencies []string `json:"dependencies,omitempty"`
-	From         string   `json:"from,omitempty"`
-	Steps        []string `json:"steps,omitempty"`
+	// Manifests references the ecosystem manifest files (e.g.
+	// go.mod/go.sum, package.json/package-lock.json, pom.xml) the
+	// dependency resolver expands into the flattened Dependency list
+	// consumed by the assembler.
+	Manifests []DependencyManifest `json:"manifests,omitempty"`
+
+	From  string   `json:"from,omitempty"`
+	Steps []string `json:"steps,omitempty"`
+
+	// Platforms requests a multi-arch build: when set, the assembler
+	// produces one image per platform and pushes them as a single
+	// OCI image index.
+	Platforms []Platform `json:"platforms,omitempty"`
 
 	// Registry ---
 	Registry struct {
@@ -101,16 +248,209 @@ type ContainerImageSpec struct {
 		// Execution describe how to execute the assemble phase.
 		Execution ExecutionType `json:"execution,omitempty"`
 	}
+
+	// Scan configures the SBOM generation and vulnerability scan that
+	// run before the image is pushed to the registry. A nil value
+	// disables the Scanning phase altogether.
+	Scan *ContainerImageScan `json:"scan,omitempty"`
+}
+
+// ContainerImageScan configures how a ContainerImage is scanned for
+// vulnerabilities and which SBOM is attached to its Status.
+type ContainerImageScan struct {
+	// Type selects the scanner implementation (e.g. trivy, grype, syft)
+	// used to produce the SBOM and the vulnerability report.
+	Type ScannerType `json:"type,omitempty"`
+
+	// FailOn is the minimum severity that causes the build to fail
+	// once the image has been scanned.
+	FailOn Severity `json:"failOn,omitempty"`
+
+	// Metadata add additional, scanner specific, configuration.
+	Metadata map[string]string `json:"metadata,omitempty"`
+}
+
+// Platform identifies a target OS/architecture combination an image
+// is built for, following the OCI image-spec platform object.
+type Platform struct {
+	// OS is the target operating system, e.g. linux.
+	OS string `json:"os,omitempty"`
+
+	// Architecture is the target CPU architecture, e.g. amd64, arm64.
+	Architecture string `json:"architecture,omitempty"`
+
+	// Variant of the CPU, e.g. v7 for arm.
+	Variant string `json:"variant,omitempty"`
+
+	// OSVersion of the operating system targeted.
+	OSVersion string `json:"osVersion,omitempty"`
+
+	// OSFeatures required by the operating system.
+	OSFeatures []string `json:"osFeatures,omitempty"`
+}
+
+// DependencyManifest references the native ecosystem manifest files
+// (and their lock files, where the ecosystem has one) that the
+// dependency resolver expands into the flattened Dependency list
+// consumed by the assembler, e.g. go.mod+go.sum or
+// package.json+package-lock.json.
+type DependencyManifest struct {
+	// Ecosystem the manifest belongs to.
+	Ecosystem Ecosystem `json:"ecosystem,omitempty"`
+
+	// ConfigMapRef points at a ConfigMap holding the manifest files,
+	// keyed by file name (e.g. "go.mod", "go.sum").
+	ConfigMapRef *corev1.LocalObjectReference `json:"configMapRef,omitempty"`
+
+	// SecretRef points at a Secret holding the manifest files, keyed
+	// by file name, for manifests that may embed credentials.
+	SecretRef *corev1.LocalObjectReference `json:"secretRef,omitempty"`
+}
+
+// Dependency is a single, resolved dependency of a ContainerImage
+// build, expanded from a DependencyManifest by the resolver
+// subsystem.
+type Dependency struct {
+	// Ecosystem the dependency belongs to.
+	Ecosystem Ecosystem `json:"ecosystem,omitempty"`
+
+	// Coordinates identify the dependency within its Ecosystem, e.g.
+	// a Go module path or a Maven groupId:artifactId.
+	Coordinates string `json:"coordinates,omitempty"`
+
+	// Version of the dependency.
+	Version string `json:"version,omitempty"`
+
+	// Checksum of the resolved artifact, as recorded in the
+	// ecosystem's lock file.
+	Checksum string `json:"checksum,omitempty"`
+
+	// Scope the dependency applies to, e.g. runtime, build, test.
+	Scope string `json:"scope,omitempty"`
+}
+
+// The well-known OCI annotation keys recognized on Spec.Registry and
+// ObjectMeta and propagated into the pushed manifest/index, see
+// https://github.com/opencontainers/image-spec/blob/main/annotations.md
+const (
+	AnnotationImageCreated     = "org.opencontainers.image.created"
+	AnnotationImageSource      = "org.opencontainers.image.source"
+	AnnotationImageRevision    = "org.opencontainers.image.revision"
+	AnnotationImageTitle       = "org.opencontainers.image.title"
+	AnnotationImageDescription = "org.opencontainers.image.description"
+	AnnotationImageLicenses    = "org.opencontainers.image.licenses"
+)
+
+// Descriptor is an OCI content descriptor, it uniquely identifies a
+// piece of content (an image manifest or an image index) addressable
+// by digest.
+type Descriptor struct {
+	// MediaType of the referenced content, e.g.
+	// application/vnd.oci.image.manifest.v1+json.
+	MediaType string `json:"mediaType,omitempty"`
+
+	// Digest of the referenced content.
+	Digest string `json:"digest,omitempty"`
+
+	// Size in bytes of the referenced content.
+	Size int64 `json:"size,omitempty"`
+
+	// Platform the referenced content was built for, set when the
+	// descriptor is an entry of an image index.
+	Platform *Platform `json:"platform,omitempty"`
+
+	// Annotations carried by the descriptor, e.g. the standard
+	// org.opencontainers.image.* keys.
+	Annotations map[string]string `json:"annotations,omitempty"`
+
+	// URLs from which the content may alternatively be downloaded.
+	URLs []string `json:"urls,omitempty"`
+}
+
+// Package describes a single dependency discovered by the scanner
+// while generating the SBOM, following the ecosystem taxonomy used
+// by tools such as Trivy.
+type Package struct {
+	// Ecosystem the package belongs to.
+	Ecosystem Ecosystem `json:"ecosystem,omitempty"`
+
+	// Name of the package.
+	Name string `json:"name,omitempty"`
+
+	// Version of the package.
+	Version string `json:"version,omitempty"`
+
+	// License declared by the package, if any.
+	License string `json:"license,omitempty"`
+
+	// FilePath is the location of the package within the image layer
+	// it was found in.
+	FilePath string `json:"filePath,omitempty"`
+}
+
+// SBOM is the Software Bill of Materials generated for a ContainerImage,
+// organized per image layer, each identified by its layer digest.
+type SBOM struct {
+	// Layers holds the packages discovered in each layer of the image.
+	Layers []SBOMLayer `json:"layers,omitempty"`
+}
+
+// SBOMLayer ---
+type SBOMLayer struct {
+	// Digest of the layer the packages were discovered in.
+	Digest string `json:"digest,omitempty"`
+
+	// Packages found in this layer.
+	Packages []Package `json:"packages,omitempty"`
+}
+
+// Vulnerability describes a single finding reported by the scanner
+// against one of the packages listed in Status.SBOM.
+type Vulnerability struct {
+	// ID of the vulnerability, e.g. a CVE identifier.
+	ID string `json:"id,omitempty"`
+
+	// PackageName the vulnerability was found in.
+	PackageName string `json:"packageName,omitempty"`
+
+	// Severity of the vulnerability.
+	Severity Severity `json:"severity,omitempty"`
+
+	// FixedVersion is the first version of the package that resolves
+	// the vulnerability, if known.
+	FixedVersion string `json:"fixedVersion,omitempty"`
+
+	// Description of the vulnerability.
+	Description string `json:"description,omitempty"`
 }
 
 // ContainerImageStatus ---
 type ContainerImageStatus struct {
-	// Image is the final image name
-	Image string `json:"image,omitempty"`
+	// Descriptor is the OCI descriptor of the pushed image, or of the
+	// image index when Spec.Platforms produces a multi-arch build.
+	Descriptor *Descriptor `json:"descriptor,omitempty"`
+
+	// Index holds the per-platform descriptors when the build targets
+	// multiple platforms, mirroring an OCI
+	// application/vnd.oci.image.index.v1+json manifest.
+	Index []Descriptor `json:"index,omitempty"`
 
 	// The phase in which the container image is
 	Phase ContainerImagePhase `json:"phase,omitempty"`
 
 	// Conditions detail the current conditions of this container image process.
 	Conditions []ContainerImageCondition `json:"conditions,omitempty"`
+
+	// SBOM is the Software Bill of Materials generated for the image
+	// during the Scanning phase.
+	SBOM *SBOM `json:"sbom,omitempty"`
+
+	// Vulnerabilities found while scanning the image, populated
+	// alongside the Scanned condition.
+	Vulnerabilities []Vulnerability `json:"vulnerabilities,omitempty"`
+
+	// DependenciesResolved counts, per Ecosystem, the Dependency
+	// entries expanded from Spec.Manifests, populated alongside the
+	// DependenciesResolved condition.
+	DependenciesResolved map[Ecosystem]int `json:"dependenciesResolved,omitempty"`
 }